@@ -0,0 +1,170 @@
+// Package otelspanexporter adapts spans produced by the OpenTelemetry SDK
+// onto the same Prometheus collector/histogram/label machinery as
+// spanexporter.Exporter, so dashboards built against that package keep
+// working while a service migrates off the (now EOL) OpenCensus SDK.
+package otelspanexporter
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	octrace "go.opencensus.io/trace"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/waxmoth/fn/api/server/spanexporter"
+)
+
+// Options contains options for configuring the exporter. It's the same
+// Options accepted by spanexporter.NewExporter, since ExportSpans just
+// translates each ReadOnlySpan and hands it to the existing collector.
+type Options = spanexporter.Options
+
+// Exporter implements go.opentelemetry.io/otel/sdk/trace.SpanExporter,
+// reusing a spanexporter.Exporter for collection so that a single
+// Prometheus registry can serve both OpenCensus and OpenTelemetry spans
+// during a migration.
+type Exporter struct {
+	inner *spanexporter.Exporter
+}
+
+var _ sdktrace.SpanExporter = (*Exporter)(nil)
+
+// NewExporter returns an exporter that exports OpenTelemetry spans to
+// Prometheus via the same machinery as spanexporter.Exporter.
+func NewExporter(o Options) (*Exporter, error) {
+	inner, err := spanexporter.NewExporter(o)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{inner: inner}, nil
+}
+
+// ExportSpans translates each ReadOnlySpan into the sanitized name +
+// duration + status + attribute-label flow already established by
+// spanexporter.Exporter, and records it.
+func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	for _, s := range spans {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		e.inner.ExportSpan(convertSpan(s))
+	}
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter. There's nothing to flush:
+// every span is recorded synchronously in ExportSpans.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// convertSpan translates an OpenTelemetry ReadOnlySpan into the
+// octrace.SpanData shape spanexporter.Exporter already knows how to
+// collect, so no parallel name-sanitizing/bucketing/labeling logic is
+// needed here.
+func convertSpan(s sdktrace.ReadOnlySpan) *octrace.SpanData {
+	attrs := make(map[string]interface{}, len(s.Attributes()))
+	for _, kv := range s.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.AsInterface()
+	}
+
+	sc := s.SpanContext()
+	return &octrace.SpanData{
+		SpanContext: octrace.SpanContext{
+			TraceID: octrace.TraceID(sc.TraceID()),
+			SpanID:  octrace.SpanID(sc.SpanID()),
+		},
+		Name:       s.Name(),
+		StartTime:  s.StartTime(),
+		EndTime:    s.EndTime(),
+		Attributes: attrs,
+		Status:     convertStatus(s.Status()),
+	}
+}
+
+// convertStatus maps an OpenTelemetry status onto the gRPC-style status
+// codes spanexporter.Exporter's status-aware counters expect.
+// OpenTelemetry only distinguishes Unset/Error/Ok, so a failed span is
+// reported as the generic UNKNOWN (2) rather than a specific gRPC code; the
+// original description is preserved in Message.
+func convertStatus(s sdktrace.Status) octrace.Status {
+	code := int32(0) // OK
+	if s.Code == codes.Error {
+		code = 2 // UNKNOWN
+	}
+	return octrace.Status{
+		Code:    code,
+		Message: s.Description,
+	}
+}
+
+// IntervalReader periodically pulls metrics off a Gatherer and hands them
+// to Handler, mirroring OpenCensus's metricexport.Reader for callers who
+// want push semantics instead of relying on a Prometheus scrape.
+type IntervalReader struct {
+	// Gatherer is scraped every Interval. Typically the *prometheus.Registry
+	// passed in via Options.Registry.
+	Gatherer prometheus.Gatherer
+	// Interval is the pull period.
+	Interval time.Duration
+	// Handler receives the gathered metric families.
+	Handler func(ctx context.Context, mfs []*dto.MetricFamily) error
+	// OnError is called with any error from Gatherer.Gather or Handler. If
+	// nil, the error is logged.
+	OnError func(err error)
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// Start begins pulling on a goroutine. Callers must call Stop to release
+// it, or cancel ctx.
+func (r *IntervalReader) Start(ctx context.Context) {
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	go r.run(ctx)
+}
+
+// Stop ends the pull loop and waits for it to exit.
+func (r *IntervalReader) Stop() {
+	close(r.stop)
+	<-r.done
+}
+
+func (r *IntervalReader) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			mfs, err := r.Gatherer.Gather()
+			if err != nil {
+				r.onError(err)
+				continue
+			}
+			if err := r.Handler(ctx, mfs); err != nil {
+				r.onError(err)
+			}
+		}
+	}
+}
+
+func (r *IntervalReader) onError(err error) {
+	if r.OnError != nil {
+		r.OnError(err)
+	} else {
+		log.Printf("otelspanexporter: interval read failed: %v", err)
+	}
+}
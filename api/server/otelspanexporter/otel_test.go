@@ -0,0 +1,195 @@
+package otelspanexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func readOnlySpan(t *testing.T, stub tracetest.SpanStub) sdktrace.ReadOnlySpan {
+	t.Helper()
+	return stub.Snapshot()
+}
+
+func TestConvertSpan(t *testing.T) {
+	start := time.Unix(0, 0)
+	end := start.Add(250 * time.Millisecond)
+
+	stub := tracetest.SpanStub{
+		Name:      "/users/42",
+		StartTime: start,
+		EndTime:   end,
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		}),
+		Attributes: []attribute.KeyValue{
+			attribute.String("http.route", "/users/:id"),
+			attribute.String("http.method", "GET"),
+		},
+		Status: sdktrace.Status{Code: codes.Error, Description: "boom"},
+	}
+
+	sd := convertSpan(readOnlySpan(t, stub))
+
+	if sd.Name != "/users/42" {
+		t.Errorf("Name = %q, want /users/42", sd.Name)
+	}
+	if !sd.StartTime.Equal(start) || !sd.EndTime.Equal(end) {
+		t.Errorf("StartTime/EndTime not preserved: got %v/%v", sd.StartTime, sd.EndTime)
+	}
+	if sd.SpanContext.TraceID.String() != "0102030405060708090a0b0c0d0e0f10" {
+		t.Errorf("TraceID = %s, want 0102030405060708090a0b0c0d0e0f10", sd.SpanContext.TraceID.String())
+	}
+	if sd.Attributes["http.route"] != "/users/:id" || sd.Attributes["http.method"] != "GET" {
+		t.Errorf("Attributes not preserved: %v", sd.Attributes)
+	}
+	if sd.Status.Code != 2 {
+		t.Errorf("Status.Code = %d, want 2 (UNKNOWN) for an Error status", sd.Status.Code)
+	}
+	if sd.Status.Message != "boom" {
+		t.Errorf("Status.Message = %q, want boom", sd.Status.Message)
+	}
+}
+
+func TestConvertStatus(t *testing.T) {
+	cases := []struct {
+		in   sdktrace.Status
+		want int32
+	}{
+		{sdktrace.Status{Code: codes.Unset}, 0},
+		{sdktrace.Status{Code: codes.Ok}, 0},
+		{sdktrace.Status{Code: codes.Error}, 2},
+	}
+	for _, tc := range cases {
+		if got := convertStatus(tc.in).Code; got != tc.want {
+			t.Errorf("convertStatus(%v).Code = %d, want %d", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestExportSpans(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	e, err := NewExporter(Options{Registry: reg})
+	if err != nil {
+		t.Fatalf("NewExporter: %v", err)
+	}
+
+	stub := tracetest.SpanStub{
+		Name:      "do_work",
+		StartTime: time.Unix(0, 0),
+		EndTime:   time.Unix(0, 0).Add(5 * time.Millisecond),
+	}
+
+	if err := e.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{readOnlySpan(t, stub)}); err != nil {
+		t.Fatalf("ExportSpans: %v", err)
+	}
+
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+	var found bool
+	for _, mf := range mfs {
+		if mf.GetName() == "do_work" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("do_work histogram not found in %d families", len(mfs))
+	}
+}
+
+func TestExportSpans_ContextCanceled(t *testing.T) {
+	e, err := NewExporter(Options{})
+	if err != nil {
+		t.Fatalf("NewExporter: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	stub := tracetest.SpanStub{Name: "do_work"}
+	if err := e.ExportSpans(ctx, []sdktrace.ReadOnlySpan{readOnlySpan(t, stub)}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("ExportSpans with a canceled context = %v, want context.Canceled", err)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	e, err := NewExporter(Options{})
+	if err != nil {
+		t.Fatalf("NewExporter: %v", err)
+	}
+
+	if err := e.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown(background) = %v, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := e.Shutdown(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Shutdown(canceled) = %v, want context.Canceled", err)
+	}
+}
+
+func TestIntervalReader(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	e, err := NewExporter(Options{Registry: reg})
+	if err != nil {
+		t.Fatalf("NewExporter: %v", err)
+	}
+	stub := tracetest.SpanStub{Name: "do_work"}
+	if err := e.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{readOnlySpan(t, stub)}); err != nil {
+		t.Fatalf("ExportSpans: %v", err)
+	}
+
+	calls := make(chan []*dto.MetricFamily, 4)
+	r := &IntervalReader{
+		Gatherer: reg,
+		Interval: 5 * time.Millisecond,
+		Handler: func(ctx context.Context, mfs []*dto.MetricFamily) error {
+			calls <- mfs
+			return nil
+		},
+	}
+
+	r.Start(context.Background())
+	defer r.Stop()
+
+	select {
+	case mfs := <-calls:
+		if len(mfs) == 0 {
+			t.Fatalf("Handler called with no metric families")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Handler was not called within 1s")
+	}
+}
+
+func TestIntervalReader_Stop(t *testing.T) {
+	r := &IntervalReader{
+		Gatherer: prometheus.NewRegistry(),
+		Interval: time.Millisecond,
+		Handler: func(ctx context.Context, mfs []*dto.MetricFamily) error {
+			return nil
+		},
+	}
+	r.Start(context.Background())
+	r.Stop()
+
+	select {
+	case <-r.done:
+	default:
+		t.Fatalf("Stop returned before run's done channel was closed")
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"strings"
 	"sync"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/stats"
 	view "go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"go.opencensus.io/trace"
 )
 
@@ -26,8 +28,23 @@ type Converter struct {
 type Options struct {
 	Namespace string
 	Exporter  view.Exporter
+
+	// EnableExemplars tags each recorded measurement with the span's trace
+	// ID and span ID, the closest OpenCensus stats equivalent of a
+	// Prometheus exemplar (see spanexporter.Options.EnableExemplars, which
+	// attaches real OpenMetrics exemplars), so a latency spike can still be
+	// correlated back to a trace.
+	EnableExemplars bool
 }
 
+// traceIDKey and spanIDKey are the tag keys used to correlate a recorded
+// measurement back to the trace it came from when Options.EnableExemplars
+// is set.
+var (
+	traceIDKey = tag.MustNewKey("trace_id")
+	spanIDKey  = tag.MustNewKey("span_id")
+)
+
 func NewConverter(o Options) (*Converter, error) {
 	e := &Converter{
 		opts:     o,
@@ -48,7 +65,17 @@ func (c *Converter) ExportSpan(sd *trace.SpanData) {
 	spanTimeNanos := sd.EndTime.Sub(sd.StartTime)
 	spanTimeMillis := float64(int64(spanTimeNanos / time.Millisecond))
 
-	m.M(spanTimeMillis)
+	ctx := context.Background()
+	if c.opts.EnableExemplars {
+		tagged, err := tag.New(ctx,
+			tag.Insert(traceIDKey, sd.SpanContext.TraceID.String()),
+			tag.Insert(spanIDKey, sd.SpanContext.SpanID.String()))
+		if err == nil {
+			ctx = tagged
+		}
+	}
+
+	stats.Record(ctx, m.M(spanTimeMillis))
 }
 
 func (c *Converter) getMeasure(span *trace.SpanData) *stats.Float64Measure {
@@ -66,6 +93,9 @@ func (c *Converter) getMeasure(span *trace.SpanData) *stats.Float64Measure {
 			Measure:     m,
 			Aggregation: view.Distribution(0, 1<<32, 2<<32, 3<<32),
 		}
+		if c.opts.EnableExemplars {
+			v.TagKeys = []tag.Key{traceIDKey, spanIDKey}
+		}
 		// Buckets: []float64{1,
 		// 	10,
 		// 	50,
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/trace"
+)
+
+func testSpan(name string, traceID byte, spanID byte) *trace.SpanData {
+	start := time.Unix(0, 0)
+	sd := &trace.SpanData{
+		Name:      name,
+		StartTime: start,
+		EndTime:   start.Add(5 * time.Millisecond),
+	}
+	sd.SpanContext.TraceID[0] = traceID
+	sd.SpanContext.SpanID[0] = spanID
+	return sd
+}
+
+// retrieveData polls view.RetrieveData briefly, since the view worker applies
+// recorded measurements on its own goroutine.
+func retrieveData(t *testing.T, viewName string) []*view.Row {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		rows, err := view.RetrieveData(viewName)
+		if err != nil {
+			t.Fatalf("RetrieveData(%q): %v", viewName, err)
+		}
+		if len(rows) > 0 {
+			return rows
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("RetrieveData(%q): no data recorded within timeout", viewName)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestExportSpan_RecordsMeasurement guards against the bug fixed in
+// 9181f8c, where the non-exemplar path built the Measurement but never
+// called stats.Record, silently dropping every observation for a Converter
+// that didn't set EnableExemplars.
+func TestExportSpan_RecordsMeasurement(t *testing.T) {
+	c, err := NewConverter(Options{Namespace: "conv_test_plain"})
+	if err != nil {
+		t.Fatalf("NewConverter: %v", err)
+	}
+
+	c.ExportSpan(testSpan("op", 1, 1))
+
+	rows := retrieveData(t, "conv_test_plain_op")
+	if dist, ok := rows[0].Data.(*view.DistributionData); !ok || dist.Count != 1 {
+		t.Fatalf("got row data %#v, want a DistributionData with Count 1", rows[0].Data)
+	}
+}
+
+// TestExportSpan_RecordsMeasurement_WithExemplars exercises the
+// EnableExemplars path, verifying the measurement is still recorded and
+// tagged with the span's trace and span IDs.
+func TestExportSpan_RecordsMeasurement_WithExemplars(t *testing.T) {
+	c, err := NewConverter(Options{Namespace: "conv_test_exemplar", EnableExemplars: true})
+	if err != nil {
+		t.Fatalf("NewConverter: %v", err)
+	}
+
+	sd := testSpan("op", 2, 2)
+	c.ExportSpan(sd)
+
+	rows := retrieveData(t, "conv_test_exemplar_op")
+	if dist, ok := rows[0].Data.(*view.DistributionData); !ok || dist.Count != 1 {
+		t.Fatalf("got row data %#v, want a DistributionData with Count 1", rows[0].Data)
+	}
+
+	wantTraceID := sd.SpanContext.TraceID.String()
+	var gotTraceID string
+	for _, tg := range rows[0].Tags {
+		if tg.Key == traceIDKey {
+			gotTraceID = tg.Value
+		}
+	}
+	if gotTraceID != wantTraceID {
+		t.Fatalf("trace_id tag = %q, want %q", gotTraceID, wantTraceID)
+	}
+}
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"go.opencensus.io/trace"
 )
@@ -31,6 +35,94 @@ type Options struct {
 	Namespace string
 	Registry  *prometheus.Registry
 	OnError   func(err error)
+
+	// ConstLabels are applied to every histogram registered by the
+	// collector, mirroring upstream opencensus-go's Prometheus exporter.
+	ConstLabels prometheus.Labels
+
+	// AttributeLabels names span attributes that should be lifted into
+	// label values on the histogram. When non-empty, getHistogram
+	// constructs a HistogramVec keyed on these label names instead of a
+	// single Histogram.
+	AttributeLabels []string
+
+	// DefaultBuckets overrides the built-in latency buckets used for every
+	// histogram that BucketsFunc doesn't handle. Falls back to
+	// defaultBuckets when nil.
+	DefaultBuckets []float64
+
+	// BucketsFunc, when set, is consulted per span so callers can pick
+	// exponential/linear buckets or SLO-tuned buckets per operation. A nil
+	// return falls through to DefaultBuckets.
+	BucketsFunc func(span *trace.SpanData) []float64
+
+	// NativeHistogramBucketFactor and NativeHistogramMaxBucketNumber are
+	// plumbed straight into prometheus.HistogramOpts, letting callers on a
+	// modern client_golang opt into Prometheus native (sparse) histograms
+	// for far cheaper high-resolution latency tracking. Leave both zero to
+	// keep using the classic (explicit-bucket) histograms.
+	NativeHistogramBucketFactor    float64
+	NativeHistogramMaxBucketNumber uint32
+
+	// EnableExemplars attaches the span's trace ID and span ID as an
+	// exemplar on each latency observation, so that a latency spike in
+	// Grafana can jump straight to the trace in Jaeger/Tempo. Requires a
+	// Prometheus scrape in OpenMetrics format to actually see the
+	// exemplars; see Exporter.ServeHTTP.
+	EnableExemplars bool
+
+	// NameMapper is consulted for every span whose name looks like an HTTP
+	// path (e.g. Gin's raw "/users/42/orders/7") instead of unconditionally
+	// dropping it. It returns the metric name to record under, the label
+	// values to attach (e.g. templated route + method), and whether the
+	// span should still be dropped. Defaults to DefaultNameMapper.
+	NameMapper func(sd *trace.SpanData) (name string, labels map[string]string, drop bool)
+
+	// MaxSeries caps the number of distinct label-value combinations
+	// NameMapper is allowed to produce. Once reached, further unseen
+	// combinations are dropped and reported via OnError instead of growing
+	// the series count without bound.
+	MaxSeries int
+}
+
+// DefaultNameMapper templates Gin-style HTTP spans using the "http.route"
+// and "http.method" attributes populated by ochttp/otelgin, emitting a
+// single "http_request" metric with "route" and "method" labels instead of
+// one series per concrete path. Spans with neither attribute are dropped,
+// matching the historical behavior of this exporter.
+func DefaultNameMapper(sd *trace.SpanData) (string, map[string]string, bool) {
+	route, hasRoute := sd.Attributes["http.route"]
+	method, hasMethod := sd.Attributes["http.method"]
+	if !hasRoute && !hasMethod {
+		return "", nil, true
+	}
+	labels := map[string]string{}
+	if hasRoute {
+		labels["route"] = fmt.Sprint(route)
+	} else {
+		labels["route"] = ""
+	}
+	if hasMethod {
+		labels["method"] = fmt.Sprint(method)
+	} else {
+		labels["method"] = ""
+	}
+	return "http_request", labels, false
+}
+
+// bucketsFor resolves the bucket boundaries to use for span, preferring
+// Options.BucketsFunc, then Options.DefaultBuckets, then the package
+// default.
+func (o *Options) bucketsFor(span *trace.SpanData) []float64 {
+	if o.BucketsFunc != nil {
+		if buckets := o.BucketsFunc(span); buckets != nil {
+			return buckets
+		}
+	}
+	if o.DefaultBuckets != nil {
+		return o.DefaultBuckets
+	}
+	return defaultBuckets
 }
 
 // NewExporter returns an exporter that exports stats to Prometheus.
@@ -43,10 +135,20 @@ func NewExporter(o Options) (*Exporter, error) {
 		opts: o,
 		g:    o.Registry,
 		c:    collector,
+		handler: promhttp.HandlerFor(o.Registry, promhttp.HandlerOpts{
+			EnableOpenMetrics: true,
+		}),
 	}
 	return e, nil
 }
 
+// ServeHTTP implements http.Handler, serving the collected metrics in
+// OpenMetrics format so that exemplars (see Options.EnableExemplars) are
+// visible to scrapers.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.handler.ServeHTTP(w, r)
+}
+
 // ExportSpan exports to the Prometheus
 // Each OpenCensus AggregationData will be converted to
 // corresponding Prometheus Metric: SumData will be converted
@@ -54,48 +156,334 @@ func NewExporter(o Options) (*Exporter, error) {
 // DistributionData will be a Histogram Metric.
 func (e *Exporter) ExportSpan(sd *trace.SpanData) {
 	if urlName(sd.Name) {
+		e.exportRoutedSpan(sd)
 		return
 	}
+
 	histo := e.c.getHistogram(sd)
+	e.c.getCounter(sd).WithLabelValues(statusName(sd.Status.Code), strconv.FormatInt(int64(sd.Status.Code), 10)).Inc()
+
+	e.observe(sd, histo)
+}
+
+// exportRoutedSpan handles spans whose raw name looks like an HTTP path
+// (see urlName) by running them through Options.NameMapper, which collapses
+// concrete paths like "/users/42/orders/7" into templated routes instead of
+// spawning a fresh series per request.
+func (e *Exporter) exportRoutedSpan(sd *trace.SpanData) {
+	mapper := e.opts.NameMapper
+	if mapper == nil {
+		mapper = DefaultNameMapper
+	}
+
+	name, labels, drop := mapper(sd)
+	if drop {
+		return
+	}
+
+	labelNames, labelValues := sortedLabelPairs(labels)
+
+	sig := name
+	for _, v := range labelValues {
+		sig += "\x00" + v
+	}
+	if !e.c.routeSeriesAllowed(sig) {
+		e.opts.onError(fmt.Errorf("dropping series for route %q %v: MaxSeries (%d) exceeded", name, labelValues, e.opts.MaxSeries))
+		return
+	}
+
+	histo := e.c.getRouteHistogram(name, labelNames, labelValues, sd)
+
+	statusValues := append(append([]string{}, labelValues...), statusName(sd.Status.Code), strconv.FormatInt(int64(sd.Status.Code), 10))
+	e.c.getRouteCounter(name, labelNames).WithLabelValues(statusValues...).Inc()
 
+	e.observe(sd, histo)
+}
+
+// sortedLabelPairs returns labels' keys and matching values, ordered
+// alphabetically by key, so the same label set always produces the same
+// HistogramVec/CounterVec regardless of map iteration order.
+func sortedLabelPairs(labels map[string]string) (names []string, values []string) {
+	names = make([]string, 0, len(labels))
+	for k := range labels {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	values = make([]string, len(names))
+	for i, k := range names {
+		values[i] = labels[k]
+	}
+	return names, values
+}
+
+// observe records a span's duration against histo, attaching a trace
+// exemplar when Options.EnableExemplars is set and the label set stays
+// within the OpenMetrics exemplar size limit.
+func (e *Exporter) observe(sd *trace.SpanData, histo prometheus.Observer) {
 	spanTimeSpanNanos := sd.EndTime.Sub(sd.StartTime)
 	spanTimeSpanMillis := float64(int64(spanTimeSpanNanos / time.Millisecond))
 
+	if e.opts.EnableExemplars {
+		if exemplarObserver, ok := histo.(prometheus.ExemplarObserver); ok {
+			labels := exemplarLabels(sd)
+			if exemplarLabelsWithinLimit(labels) {
+				exemplarObserver.ObserveWithExemplar(spanTimeSpanMillis, labels)
+				return
+			}
+			e.opts.onError(fmt.Errorf("exemplar labels for span %q exceed the %d-rune OpenMetrics limit, falling back to a plain observation", sd.Name, exemplarLabelRuneLimit))
+		}
+	}
+
 	histo.Observe(spanTimeSpanMillis)
 }
 
+// exemplarLabels builds the exemplar label set identifying the trace that
+// produced an observation.
+func exemplarLabels(sd *trace.SpanData) prometheus.Labels {
+	return prometheus.Labels{
+		"trace_id": sd.SpanContext.TraceID.String(),
+		"span_id":  sd.SpanContext.SpanID.String(),
+	}
+}
+
+// exemplarLabelRuneLimit mirrors the OpenMetrics exposition format's cap on
+// the total size of a serialized exemplar label set.
+const exemplarLabelRuneLimit = 128
+
+// exemplarLabelsWithinLimit reports whether labels would stay under the
+// OpenMetrics exemplar label-set size limit once serialized.
+func exemplarLabelsWithinLimit(labels prometheus.Labels) bool {
+	n := 0
+	for k, v := range labels {
+		n += utf8.RuneCountInString(k) + utf8.RuneCountInString(v)
+	}
+	return n <= exemplarLabelRuneLimit
+}
+
 var _ trace.Exporter = (*Exporter)(nil)
 
-func (c *collector) getHistogram(span *trace.SpanData) prometheus.Histogram {
-	sig := spanName(c.opts.Namespace, span)
+var defaultBuckets = []float64{1,
+	10,
+	50,
+	100,
+	250,
+	500,
+	1000,
+	10000,
+	60000,
+	120000}
+
+// getHistogram returns the Observer that a span's duration should be
+// recorded against. When Options.AttributeLabels is empty this is a plain
+// Histogram, keyed by span name and cached in registeredHistograms.
+// Otherwise it's one series of a HistogramVec keyed by the tuple of (span
+// name, attribute label values), so that e.g. per-tenant or per-status
+// dashboards don't require a fresh series per request ID; the Vec itself
+// (not its per-combination children) is what's cached and what Describe/
+// Collect walk, so each labeled histogram is only ever collected once.
+func (c *collector) getHistogram(span *trace.SpanData) prometheus.Observer {
+	if len(c.opts.AttributeLabels) > 0 {
+		name := spanName(c.opts.Namespace, span)
+		labelValues := attributeLabelValues(span, c.opts.AttributeLabels)
+		c.ensureRegisteredOnce()
+		return c.getHistogramVec(name, span).WithLabelValues(labelValues...)
+	}
+
+	name := spanName(c.opts.Namespace, span)
+
 	c.registeredHistosMu.Lock()
-	histogram, ok := c.registeredHistograms[sig]
+	observer, ok := c.registeredHistograms[name]
 	c.registeredHistosMu.Unlock()
 
 	if !ok {
-		histogram = prometheus.NewHistogram(
-			prometheus.HistogramOpts{Namespace: c.opts.Namespace,
-				Name: sanitize(span.Name),
-				Help: sanitize(span.Name),
-				Buckets: []float64{1,
-					10,
-					50,
-					100,
-					250,
-					500,
-					1000,
-					10000,
-					60000,
-					120000},
-			})
+		observer = prometheus.NewHistogram(c.histogramOpts(span))
 		c.registeredHistosMu.Lock()
-		c.registeredHistograms[sig] = histogram
+		c.registeredHistograms[name] = observer
 		c.registeredHistosMu.Unlock()
 	}
 
 	c.ensureRegisteredOnce()
 
-	return histogram
+	return observer
+}
+
+// getHistogramVec returns the HistogramVec for span's name, creating and
+// registering it for Collect/Describe on first use.
+func (c *collector) getHistogramVec(name string, span *trace.SpanData) *prometheus.HistogramVec {
+	c.registeredVecsMu.Lock()
+	defer c.registeredVecsMu.Unlock()
+
+	vec, ok := c.registeredVecs[name]
+	if !ok {
+		vec = prometheus.NewHistogramVec(c.histogramOpts(span), c.opts.AttributeLabels)
+		c.registeredVecs[name] = vec
+	}
+	return vec
+}
+
+// getCounter returns the status-aware CounterVec for span's name, creating
+// and registering it for Collect/Describe on first use. It carries a
+// "status" label (the canonical short name of sd.Status.Code) and a "code"
+// label (the raw integer) so alerting rules can use
+// rate({name}_total{status!="OK"}[5m]) without scraping the tracing
+// backend.
+func (c *collector) getCounter(span *trace.SpanData) *prometheus.CounterVec {
+	name := spanName(c.opts.Namespace, span)
+
+	c.registeredCountersMu.Lock()
+	defer c.registeredCountersMu.Unlock()
+
+	counter, ok := c.registeredCounters[name]
+	if !ok {
+		counter = prometheus.NewCounterVec(
+			prometheus.CounterOpts{Namespace: c.opts.Namespace,
+				Name:        sanitize(span.Name) + "_total",
+				Help:        sanitize(span.Name) + " span count by status",
+				ConstLabels: c.opts.ConstLabels,
+			}, []string{"status", "code"})
+		c.registeredCounters[name] = counter
+	}
+	return counter
+}
+
+// routeSeriesAllowed reports whether sig is allowed to become (or already
+// is) a distinct NameMapper-produced series, bounding cardinality to
+// Options.MaxSeries. A MaxSeries of zero or less means unbounded.
+func (c *collector) routeSeriesAllowed(sig string) bool {
+	if c.opts.MaxSeries <= 0 {
+		return true
+	}
+
+	c.routeSeriesMu.Lock()
+	defer c.routeSeriesMu.Unlock()
+
+	if _, ok := c.routeSeries[sig]; ok {
+		return true
+	}
+	if len(c.routeSeries) >= c.opts.MaxSeries {
+		return false
+	}
+	c.routeSeries[sig] = struct{}{}
+	return true
+}
+
+// getRouteHistogram returns the Observer for a NameMapper-produced route
+// metric, creating its HistogramVec on first use.
+func (c *collector) getRouteHistogram(name string, labelNames, labelValues []string, span *trace.SpanData) prometheus.Observer {
+	key := name + "|" + strings.Join(labelNames, ",")
+
+	c.routeVecsMu.Lock()
+	vec, ok := c.routeVecs[key]
+	if !ok {
+		vec = prometheus.NewHistogramVec(c.histogramOptsForName(name, span), labelNames)
+		c.routeVecs[key] = vec
+	}
+	c.routeVecsMu.Unlock()
+
+	c.ensureRegisteredOnce()
+
+	return vec.WithLabelValues(labelValues...)
+}
+
+// getRouteCounter returns the status-aware CounterVec for a NameMapper-
+// produced route metric, creating it on first use.
+func (c *collector) getRouteCounter(name string, labelNames []string) *prometheus.CounterVec {
+	key := name + "|" + strings.Join(labelNames, ",")
+
+	c.routeCountersMu.Lock()
+	defer c.routeCountersMu.Unlock()
+
+	counter, ok := c.routeCounters[key]
+	if !ok {
+		counter = prometheus.NewCounterVec(
+			prometheus.CounterOpts{Namespace: c.opts.Namespace,
+				Name:        sanitize(name) + "_total",
+				Help:        sanitize(name) + " span count by status",
+				ConstLabels: c.opts.ConstLabels,
+			}, append(append([]string{}, labelNames...), "status", "code"))
+		c.routeCounters[key] = counter
+	}
+	return counter
+}
+
+// statusName translates an OpenCensus/gRPC status code into its canonical
+// short name, e.g. for use as a Prometheus label value.
+func statusName(code int32) string {
+	switch code {
+	case 0:
+		return "OK"
+	case 1:
+		return "CANCELLED"
+	case 2:
+		return "UNKNOWN"
+	case 3:
+		return "INVALID_ARGUMENT"
+	case 4:
+		return "DEADLINE_EXCEEDED"
+	case 5:
+		return "NOT_FOUND"
+	case 6:
+		return "ALREADY_EXISTS"
+	case 7:
+		return "PERMISSION_DENIED"
+	case 8:
+		return "RESOURCE_EXHAUSTED"
+	case 9:
+		return "FAILED_PRECONDITION"
+	case 10:
+		return "ABORTED"
+	case 11:
+		return "OUT_OF_RANGE"
+	case 12:
+		return "UNIMPLEMENTED"
+	case 13:
+		return "INTERNAL"
+	case 14:
+		return "UNAVAILABLE"
+	case 15:
+		return "DATA_LOSS"
+	case 16:
+		return "UNAUTHENTICATED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// histogramOpts builds the HistogramOpts shared by both the scalar and
+// HistogramVec creation paths.
+func (c *collector) histogramOpts(span *trace.SpanData) prometheus.HistogramOpts {
+	return c.histogramOptsForName(span.Name, span)
+}
+
+// histogramOptsForName is like histogramOpts but names the metric
+// explicitly, for NameMapper-produced metrics whose name doesn't match the
+// raw span name.
+func (c *collector) histogramOptsForName(name string, span *trace.SpanData) prometheus.HistogramOpts {
+	return prometheus.HistogramOpts{
+		Namespace:                      c.opts.Namespace,
+		Name:                           sanitize(name),
+		Help:                           sanitize(name),
+		ConstLabels:                    c.opts.ConstLabels,
+		Buckets:                        c.opts.bucketsFor(span),
+		NativeHistogramBucketFactor:    c.opts.NativeHistogramBucketFactor,
+		NativeHistogramMaxBucketNumber: c.opts.NativeHistogramMaxBucketNumber,
+	}
+}
+
+// attributeLabelValues returns the string values of span's attributes named
+// by labels, in order, defaulting to the empty string for missing
+// attributes so that WithLabelValues always receives the expected arity.
+func attributeLabelValues(span *trace.SpanData, labels []string) []string {
+	if len(labels) == 0 {
+		return nil
+	}
+	values := make([]string, len(labels))
+	for i, label := range labels {
+		if v, ok := span.Attributes[label]; ok {
+			values[i] = fmt.Sprint(v)
+		}
+	}
+	return values
 }
 
 // ensureRegisteredOnce invokes reg.Register on the collector itself
@@ -132,7 +520,40 @@ type collector struct {
 
 	registeredHistosMu sync.Mutex
 
+	// registeredHistograms caches the plain, per-span-name Histogram used
+	// when Options.AttributeLabels is empty.
 	registeredHistograms map[string]prometheus.Histogram
+
+	registeredVecsMu sync.Mutex
+
+	// registeredVecs holds one HistogramVec per span name, used when
+	// Options.AttributeLabels is non-empty. Only the Vec itself is cached
+	// here (not its per-label-combination children) so Describe/Collect
+	// each collect it exactly once.
+	registeredVecs map[string]*prometheus.HistogramVec
+
+	registeredCountersMu sync.Mutex
+
+	// registeredCounters holds one status-aware CounterVec per span name.
+	registeredCounters map[string]*prometheus.CounterVec
+
+	routeSeriesMu sync.Mutex
+
+	// routeSeries tracks distinct NameMapper-produced (name, label values)
+	// signatures seen so far, bounding cardinality to Options.MaxSeries.
+	routeSeries map[string]struct{}
+
+	routeVecsMu sync.Mutex
+
+	// routeVecs holds one HistogramVec per NameMapper-produced metric name
+	// and label-name set.
+	routeVecs map[string]*prometheus.HistogramVec
+
+	routeCountersMu sync.Mutex
+
+	// routeCounters holds one status-aware CounterVec per NameMapper-
+	// produced metric name and label-name set.
+	routeCounters map[string]*prometheus.CounterVec
 }
 
 func (c *collector) Describe(ch chan<- *prometheus.Desc) {
@@ -146,6 +567,50 @@ func (c *collector) Describe(ch chan<- *prometheus.Desc) {
 	for _, desc := range registered {
 		ch <- desc
 	}
+
+	c.registeredVecsMu.Lock()
+	vecs := make([]*prometheus.HistogramVec, 0, len(c.registeredVecs))
+	for _, vec := range c.registeredVecs {
+		vecs = append(vecs, vec)
+	}
+	c.registeredVecsMu.Unlock()
+
+	for _, vec := range vecs {
+		vec.Describe(ch)
+	}
+
+	c.registeredCountersMu.Lock()
+	counters := make([]*prometheus.CounterVec, 0, len(c.registeredCounters))
+	for _, counter := range c.registeredCounters {
+		counters = append(counters, counter)
+	}
+	c.registeredCountersMu.Unlock()
+
+	for _, counter := range counters {
+		counter.Describe(ch)
+	}
+
+	c.routeVecsMu.Lock()
+	routeVecs := make([]*prometheus.HistogramVec, 0, len(c.routeVecs))
+	for _, vec := range c.routeVecs {
+		routeVecs = append(routeVecs, vec)
+	}
+	c.routeVecsMu.Unlock()
+
+	for _, vec := range routeVecs {
+		vec.Describe(ch)
+	}
+
+	c.routeCountersMu.Lock()
+	routeCounters := make([]*prometheus.CounterVec, 0, len(c.routeCounters))
+	for _, counter := range c.routeCounters {
+		routeCounters = append(routeCounters, counter)
+	}
+	c.routeCountersMu.Unlock()
+
+	for _, counter := range routeCounters {
+		counter.Describe(ch)
+	}
 }
 
 // Collect fetches the statistics from OpenCensus
@@ -153,9 +618,60 @@ func (c *collector) Describe(ch chan<- *prometheus.Desc) {
 // Collect is invoked everytime a prometheus.Gatherer is run
 // for example when the HTTP endpoint is invoked by Prometheus.
 func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	c.registeredHistosMu.Lock()
+	histos := make([]prometheus.Histogram, 0, len(c.registeredHistograms))
 	for _, histo := range c.registeredHistograms {
+		histos = append(histos, histo)
+	}
+	c.registeredHistosMu.Unlock()
+
+	for _, histo := range histos {
 		ch <- histo
 	}
+
+	c.registeredVecsMu.Lock()
+	vecs := make([]*prometheus.HistogramVec, 0, len(c.registeredVecs))
+	for _, vec := range c.registeredVecs {
+		vecs = append(vecs, vec)
+	}
+	c.registeredVecsMu.Unlock()
+
+	for _, vec := range vecs {
+		vec.Collect(ch)
+	}
+
+	c.registeredCountersMu.Lock()
+	counters := make([]*prometheus.CounterVec, 0, len(c.registeredCounters))
+	for _, counter := range c.registeredCounters {
+		counters = append(counters, counter)
+	}
+	c.registeredCountersMu.Unlock()
+
+	for _, counter := range counters {
+		counter.Collect(ch)
+	}
+
+	c.routeVecsMu.Lock()
+	routeVecs := make([]*prometheus.HistogramVec, 0, len(c.routeVecs))
+	for _, vec := range c.routeVecs {
+		routeVecs = append(routeVecs, vec)
+	}
+	c.routeVecsMu.Unlock()
+
+	for _, vec := range routeVecs {
+		vec.Collect(ch)
+	}
+
+	c.routeCountersMu.Lock()
+	routeCounters := make([]*prometheus.CounterVec, 0, len(c.routeCounters))
+	for _, counter := range c.routeCounters {
+		routeCounters = append(routeCounters, counter)
+	}
+	c.routeCountersMu.Unlock()
+
+	for _, counter := range routeCounters {
+		counter.Collect(ch)
+	}
 }
 
 func newCollector(opts Options, registrar *prometheus.Registry) *collector {
@@ -163,6 +679,11 @@ func newCollector(opts Options, registrar *prometheus.Registry) *collector {
 		reg:                  registrar,
 		opts:                 opts,
 		registeredHistograms: make(map[string]prometheus.Histogram),
+		registeredVecs:       make(map[string]*prometheus.HistogramVec),
+		routeSeries:          make(map[string]struct{}),
+		routeVecs:            make(map[string]*prometheus.HistogramVec),
+		routeCounters:        make(map[string]*prometheus.CounterVec),
+		registeredCounters:   make(map[string]*prometheus.CounterVec),
 	}
 }
 
@@ -204,8 +725,10 @@ func sanitizeRune(r rune) rune {
 	return '_'
 }
 
-//Gin creates spans for all paths, containing ID values.
-//We can safely discard these, as other histograms are being created for them.
+// urlName reports whether a span name looks like a raw HTTP path (e.g. Gin
+// creates spans named "/users/42/orders/7"). These are routed through
+// Options.NameMapper instead of being recorded under their literal,
+// unbounded-cardinality name.
 func urlName(s string) bool {
 	return strings.HasPrefix(s, "/")
 }
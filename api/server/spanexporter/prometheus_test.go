@@ -0,0 +1,165 @@
+package spanexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.opencensus.io/trace"
+)
+
+func spanAt(name string, start, end time.Time, attrs map[string]interface{}) *trace.SpanData {
+	return &trace.SpanData{
+		Name:       name,
+		StartTime:  start,
+		EndTime:    end,
+		Attributes: attrs,
+	}
+}
+
+// TestAttributeLabelsHistogram_CollectedOnce guards against the duplicate
+// collection bug fixed in the getHistogram rework: a HistogramVec series
+// must show up exactly once per Gather, not once via registeredHistograms
+// and once more via the Vec itself.
+func TestAttributeLabelsHistogram_CollectedOnce(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	e, err := NewExporter(Options{
+		Registry:        reg,
+		AttributeLabels: []string{"tenant"},
+	})
+	if err != nil {
+		t.Fatalf("NewExporter: %v", err)
+	}
+
+	start := time.Unix(0, 0)
+	e.ExportSpan(spanAt("do_work", start, start.Add(10*time.Millisecond), map[string]interface{}{"tenant": "acme"}))
+	e.ExportSpan(spanAt("do_work", start, start.Add(20*time.Millisecond), map[string]interface{}{"tenant": "globex"}))
+
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+
+	var histo *dto.MetricFamily
+	for _, mf := range mfs {
+		if mf.GetName() == "do_work" {
+			histo = mf
+		}
+	}
+	if histo == nil {
+		t.Fatalf("do_work histogram not found in %d families", len(mfs))
+	}
+	if got := len(histo.GetMetric()); got != 2 {
+		t.Fatalf("got %d series for do_work, want 2 (one per tenant, collected exactly once each)", got)
+	}
+
+	seen := map[string]bool{}
+	for _, m := range histo.GetMetric() {
+		for _, lp := range m.GetLabel() {
+			if lp.GetName() == "tenant" {
+				if seen[lp.GetValue()] {
+					t.Fatalf("tenant=%q collected more than once", lp.GetValue())
+				}
+				seen[lp.GetValue()] = true
+			}
+		}
+	}
+	if !seen["acme"] || !seen["globex"] {
+		t.Fatalf("expected series for both tenants, got %v", seen)
+	}
+}
+
+func TestDefaultNameMapper(t *testing.T) {
+	cases := []struct {
+		name       string
+		attrs      map[string]interface{}
+		wantDrop   bool
+		wantLabels map[string]string
+	}{
+		{
+			name:       "route and method",
+			attrs:      map[string]interface{}{"http.route": "/users/:id", "http.method": "GET"},
+			wantLabels: map[string]string{"route": "/users/:id", "method": "GET"},
+		},
+		{
+			name:       "route only",
+			attrs:      map[string]interface{}{"http.route": "/users/:id"},
+			wantLabels: map[string]string{"route": "/users/:id", "method": ""},
+		},
+		{
+			name:       "method only",
+			attrs:      map[string]interface{}{"http.method": "GET"},
+			wantLabels: map[string]string{"route": "", "method": "GET"},
+		},
+		{
+			name:     "neither attribute",
+			attrs:    map[string]interface{}{},
+			wantDrop: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sd := spanAt("/users/42", time.Time{}, time.Time{}, tc.attrs)
+			name, labels, drop := DefaultNameMapper(sd)
+			if drop != tc.wantDrop {
+				t.Fatalf("drop = %v, want %v", drop, tc.wantDrop)
+			}
+			if drop {
+				return
+			}
+			if name != "http_request" {
+				t.Errorf("name = %q, want http_request", name)
+			}
+			for k, want := range tc.wantLabels {
+				if got := labels[k]; got != want {
+					t.Errorf("labels[%q] = %q, want %q", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestExportRoutedSpan_MaxSeries guards the cardinality guard added for
+// NameMapper-produced routes: once Options.MaxSeries distinct routes have
+// been seen, a new route is dropped and reported via OnError instead of
+// growing the series count without bound.
+func TestExportRoutedSpan_MaxSeries(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	var errs []error
+	e, err := NewExporter(Options{
+		Registry:  reg,
+		MaxSeries: 1,
+		OnError:   func(err error) { errs = append(errs, err) },
+	})
+	if err != nil {
+		t.Fatalf("NewExporter: %v", err)
+	}
+
+	start := time.Unix(0, 0)
+	end := start.Add(time.Millisecond)
+	e.ExportSpan(spanAt("/users/1", start, end, map[string]interface{}{"http.route": "/users/:id", "http.method": "GET"}))
+	e.ExportSpan(spanAt("/orders/1", start, end, map[string]interface{}{"http.route": "/orders/:id", "http.method": "GET"}))
+
+	if len(errs) != 1 {
+		t.Fatalf("got %d OnError calls, want 1 (for the dropped second route)", len(errs))
+	}
+
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+	var histo *dto.MetricFamily
+	for _, mf := range mfs {
+		if mf.GetName() == "http_request" {
+			histo = mf
+		}
+	}
+	if histo == nil {
+		t.Fatalf("http_request histogram not found in %d families", len(mfs))
+	}
+	if got := len(histo.GetMetric()); got != 1 {
+		t.Fatalf("got %d http_request series, want 1 (the second route should have been dropped)", got)
+	}
+}